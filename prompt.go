@@ -7,11 +7,14 @@
 package prompt
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"reflect"
+	"strings"
 	"syscall"
 
 	"github.com/fyrna/x/term"
@@ -27,10 +30,24 @@ var ErrCanceled = errors.New("canceled")
 type terminal struct {
 	t             *term.Terminal
 	kr            *key.Reader
+	out           io.Writer
 	width, height int
 }
 
-func newTerminal() (*terminal, error) {
+// newTerminal opens the controlling terminal on stdin and wraps it for
+// rendering to out. A nil out defaults to os.Stdout. A non-nil in bypasses
+// stdin entirely: no raw-mode terminal is opened, and keys are read from in
+// instead, so the returned terminal can drive a prompt against an in-memory
+// pty or any other io.Reader.
+func newTerminal(out io.Writer, in io.Reader) (*terminal, error) {
+	if out == nil {
+		out = os.Stdout
+	}
+
+	if in != nil {
+		return &terminal{kr: key.NewReader(in), out: out, width: 80, height: 24}, nil
+	}
+
 	t := term.NewStdinTerminal()
 	if !t.IsTerminal() {
 		return nil, errors.New("stdin is not a terminal")
@@ -41,44 +58,50 @@ func newTerminal() (*terminal, error) {
 		return nil, err
 	}
 
-	return &terminal{t: t, width: w, height: h}, nil
+	return &terminal{t: t, kr: key.NewReader(t), out: out, width: w, height: h}, nil
+}
+
+func (t *terminal) print(a ...any) {
+	fmt.Fprint(t.out, a...)
+}
+
+func (t *terminal) printf(format string, a ...any) {
+	fmt.Fprintf(t.out, format, a...)
+}
+
+func (t *terminal) println(a ...any) {
+	fmt.Fprintln(t.out, a...)
 }
 
 func (t *terminal) clearScreenAndTop() {
-	fmt.Print("\x1b[2J\x1b[H")
+	t.print("\x1b[2J\x1b[H")
 }
 
 func (t *terminal) clearLine() {
-	fmt.Print("\x1b[2K\r")
+	t.print("\x1b[2K\r")
 }
 
 func (t *terminal) moveCursorRight(cols int) {
-	fmt.Printf("\r\x1b[%dC", cols)
-}
-
-func (t *terminal) moveCursorUp(times int) {
-	for range times {
-		fmt.Printf("\x1b[A")
-	}
+	t.printf("\r\x1b[%dC", cols)
 }
 
 func (t *terminal) removeCursor() {
-	fmt.Print("\033[?25l")
+	t.print("\033[?25l")
 }
 
 func (t *terminal) bringBack() {
-	fmt.Print("\033[?25h")
+	t.print("\033[?25h")
 }
 
 func (t *terminal) marginTop(n int) {
 	for range n {
-		fmt.Println()
+		t.println()
 	}
 }
 
 func (t *terminal) marginBottom(n int) {
 	for range n {
-		fmt.Println()
+		t.println()
 	}
 }
 
@@ -86,29 +109,148 @@ func (t *terminal) helpBar(text string) {
 	if text == "" {
 		return
 	}
-	fmt.Print("\x1b[s")                // save cursor
-	fmt.Printf("\x1b[%d;1H", t.height) // bottom row
-	fmt.Print("\x1b[2K")               // clear line
-	fmt.Print(text)
-	fmt.Print("\x1b[u") // restore cursor
+	t.print("\x1b[s")                // save cursor
+	t.printf("\x1b[%d;1H", t.height) // bottom row
+	t.print("\x1b[2K")               // clear line
+	t.print(text)
+	t.print("\x1b[u") // restore cursor
 }
 
-func runRaw(fn func(*terminal) error) error {
-	t, err := newTerminal()
-	if err != nil {
-		return err
+// frame buffers a multi-line redraw and diffs it line-by-line against the
+// previously flushed frame, rewriting only the lines that changed and
+// repositioning the cursor with a single escape sequence in the same
+// write. Select and MultiSelect build their option list into a frame
+// instead of printing line-by-line, since a plain rewrite flickers and
+// leaves stale characters behind once the line count changes (e.g. while
+// filtering or paging).
+type frame struct {
+	t      *terminal
+	cur    []string
+	prev   []string
+	resize chan os.Signal
+}
+
+// newFrame creates a frame for t. When t is backed by a real controlling
+// terminal, it also starts watching for SIGWINCH so checkResize can pick
+// up terminal resizes between redraws.
+func newFrame(t *terminal) *frame {
+	f := &frame{t: t}
+
+	if t.t != nil {
+		f.resize = make(chan os.Signal, 1)
+		signal.Notify(f.resize, syscall.SIGWINCH)
+	}
+
+	return f
+}
+
+// stop releases the frame's SIGWINCH subscription. Call it before
+// returning from the render loop that owns the frame.
+func (f *frame) stop() {
+	if f.resize != nil {
+		signal.Stop(f.resize)
+	}
+}
+
+// line appends one rendered line to the frame being built.
+func (f *frame) line(format string, a ...any) {
+	f.cur = append(f.cur, fmt.Sprintf(format, a...))
+}
+
+// checkResize drains any pending SIGWINCH, re-queries the terminal size,
+// and forces the next flush to rewrite every line rather than diff
+// against the now-stale previous frame.
+func (f *frame) checkResize() {
+	if f.resize == nil {
+		return
+	}
+
+	var resized bool
+
+	for {
+		select {
+		case <-f.resize:
+			resized = true
+			continue
+		default:
+		}
+		break
+	}
+
+	if !resized {
+		return
+	}
+
+	if w, h, err := f.t.t.GetSize(); err == nil {
+		f.t.width, f.t.height = w, h
+	}
+
+	f.prev = nil
+}
+
+// flush writes the lines that differ from the previous flush, wrapped in
+// a clear-line sequence, then repositions the cursor back to the top of
+// the frame in the same write so the next flush can redraw in place.
+func (f *frame) flush() {
+	n := len(f.cur)
+	if len(f.prev) > n {
+		n = len(f.prev)
+	}
+
+	var buf bytes.Buffer
+
+	for i := range n {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+
+		var cur, prev string
+		if i < len(f.cur) {
+			cur = f.cur[i]
+		}
+		if i < len(f.prev) {
+			prev = f.prev[i]
+		}
+
+		if cur != prev {
+			buf.WriteString("\r\x1b[2K")
+			buf.WriteString(cur)
+		}
+	}
+
+	if n > 1 {
+		fmt.Fprintf(&buf, "\x1b[%dA", n-1)
+	}
+	if n > 0 {
+		buf.WriteByte('\r')
 	}
 
-	if err := t.t.MakeRaw(); err != nil {
+	f.t.print(buf.String())
+
+	f.prev = f.cur
+	f.cur = nil
+}
+
+// runRaw opens a terminal writing to out (stdout if nil) and reading keys
+// from in (the controlling terminal, put into raw mode, if nil), runs fn
+// against it, and restores the terminal on return or on SIGINT/SIGTERM.
+func runRaw(out io.Writer, in io.Reader, fn func(*terminal) error) error {
+	t, err := newTerminal(out, in)
+	if err != nil {
 		return err
 	}
 
-	t.kr = key.NewReader(t.t)
+	if t.t != nil {
+		if err := t.t.MakeRaw(); err != nil {
+			return err
+		}
 
-	defer func() {
-		_ = t.t.Restore()
-		fmt.Println()
-	}()
+		defer func() {
+			_ = t.t.Restore()
+		}()
+	}
+
+	defer t.println()
 
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
@@ -117,7 +259,9 @@ func runRaw(fn func(*terminal) error) error {
 
 	go func() {
 		<-sig
-		t.t.Restore()
+		if t.t != nil {
+			t.t.Restore()
+		}
 		os.Exit(1)
 	}()
 
@@ -127,20 +271,34 @@ func runRaw(fn func(*terminal) error) error {
 // Theme defines the styling and layout configuration for prompts.
 // Use NewTheme() to create a new theme and modify it with Set().
 type Theme struct {
-	Prompt, Cursor, Selected, Unselected string
-	Error, SelectHelp, MultiSelectHelp   string
-	MarginTop, MarginBottom              int
+	Cursor string
+	Error  string
+	// SelectHelp and MultiSelectHelp override the help bar text shown
+	// by Select and MultiSelect. Left empty (the default), the help
+	// bar is generated from the active KeyMap's navigation, select and
+	// confirm bindings instead; a key keyLabel has no name for is shown
+	// as "key".
+	SelectHelp, MultiSelectHelp string
+	MarginTop, MarginBottom     int
+	// Mask is the rune echoed back for each character typed into a
+	// Password prompt. Defaults to '*' when left zero.
+	Mask rune
+	// Pointer marks the option under the cursor in Select and
+	// MultiSelect.
+	Pointer string
+	// Marker and Unmarker render a MultiSelect option's checked and
+	// unchecked state.
+	Marker, Unmarker string
 }
 
 var defaultTheme = Theme{
-	Prompt:          "\x1b[32m❯\x1b[0m ",
-	Selected:        "\x1b[34m✓\x1b[0m ",
-	Unselected:      "• ",
-	Error:           "",
-	MarginTop:       0,
-	MarginBottom:    1,
-	SelectHelp:      "\x1b[38;5;245m[↑↓] navigate • [enter] confirm\x1b[0m",
-	MultiSelectHelp: "\x1b[38;5;245m[↑↓] navigate • [space] select • [enter] confirm\x1b[0m",
+	Error:        "",
+	MarginTop:    0,
+	MarginBottom: 1,
+	Mask:         '*',
+	Pointer:      "\x1b[32m❯\x1b[0m ",
+	Marker:       "\x1b[34m✓\x1b[0m ",
+	Unmarker:     "• ",
 }
 
 func chooseTheme(t *Theme) Theme {
@@ -151,12 +309,11 @@ func chooseTheme(t *Theme) Theme {
 }
 
 // NewTheme creates a new theme with default values:
-// - Prompt: Green "❯" symbol
-// - Selected: Blue checkmark "✓"
-// - Unselected: Bullet "•"
-// - SelectHelp: Navigation instructions for single select
-// - MultiSelectHelp: Navigation instructions for multi-select
-// - MarginTop: 0, MarginBottom: 1
+//   - Pointer: Green "❯" symbol
+//   - Marker: Blue checkmark "✓", Unmarker: Bullet "•"
+//   - SelectHelp, MultiSelectHelp: empty, so the help bar is generated
+//     from the active KeyMap
+//   - MarginTop: 0, MarginBottom: 1
 func NewTheme() Theme {
 	return defaultTheme
 }
@@ -168,6 +325,137 @@ func (t Theme) Set(fn func(*Theme)) Theme {
 	return t
 }
 
+// KeyMap configures which keys drive navigation, selection, and
+// confirmation across Confirm, InputPrompt, Password, Select, and
+// MultiSelect.
+// Use NewKeyMap() to create one with the library's defaults (arrow
+// keys, enter, space, ctrl+c/ctrl+q to cancel) and Set() to customize it.
+type KeyMap struct {
+	Up, Down key.Key
+	Confirm  key.Key
+	Select   key.Key
+	// Cancel lists the runes that, combined with Ctrl, cancel the
+	// current prompt.
+	Cancel []rune
+	// SelectAll and InvertSelection are runes that, combined with
+	// Ctrl, toggle every visible (filtered) MultiSelect option on/off
+	// or invert their checked state. Avoid 'i' here: Ctrl+I is the byte a terminal
+	// sends for Tab, a common choice for Confirm.
+	SelectAll, InvertSelection rune
+	// Vim additionally accepts 'j'/'k' as Down/Up.
+	Vim bool
+}
+
+var defaultKeyMap = KeyMap{
+	Up:              key.Up,
+	Down:            key.Down,
+	Confirm:         key.Enter,
+	Select:          key.Space,
+	Cancel:          []rune{'c', 'q'},
+	SelectAll:       'a',
+	InvertSelection: 'r',
+}
+
+// NewKeyMap creates a new key map with the library's default bindings.
+func NewKeyMap() KeyMap {
+	return defaultKeyMap
+}
+
+// Set modifies the key map with the given function and returns the
+// updated key map. This enables method chaining for customization.
+func (k KeyMap) Set(fn func(*KeyMap)) KeyMap {
+	fn(&k)
+	return k
+}
+
+func chooseKeyMap(k *KeyMap) KeyMap {
+	if k == nil {
+		return defaultKeyMap
+	}
+	return *k
+}
+
+func (k KeyMap) isUp(ev key.Event) bool {
+	return ev.Key == k.Up || (k.Vim && ev.Key == key.Rune && ev.Rune == 'k')
+}
+
+func (k KeyMap) isDown(ev key.Event) bool {
+	return ev.Key == k.Down || (k.Vim && ev.Key == key.Rune && ev.Rune == 'j')
+}
+
+func (k KeyMap) isConfirm(ev key.Event) bool {
+	return ev.Key == k.Confirm
+}
+
+func (k KeyMap) isSelect(ev key.Event) bool {
+	return ev.Key == k.Select
+}
+
+func (k KeyMap) isCancel(ev key.Event) bool {
+	for _, r := range k.Cancel {
+		if ev.IsCtrl(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k KeyMap) isSelectAll(ev key.Event) bool {
+	return ev.IsCtrl(k.SelectAll)
+}
+
+func (k KeyMap) isInvertSelection(ev key.Event) bool {
+	return ev.IsCtrl(k.InvertSelection)
+}
+
+// keyLabel returns a short display name for k, used to build help bar
+// text from a KeyMap. Keys this package has no special label for fall
+// back to "key".
+func keyLabel(k key.Key) string {
+	switch k {
+	case key.Up:
+		return "↑"
+	case key.Down:
+		return "↓"
+	case key.Left:
+		return "←"
+	case key.Right:
+		return "→"
+	case key.Enter:
+		return "enter"
+	case key.Space:
+		return "space"
+	case key.Backspace:
+		return "backspace"
+	default:
+		return "key"
+	}
+}
+
+// navLabel describes the Up/Down bindings, including the Vim j/k
+// fallback when enabled.
+func (k KeyMap) navLabel() string {
+	up, down := keyLabel(k.Up), keyLabel(k.Down)
+	if k.Vim {
+		return fmt.Sprintf("[%s/k %s/j]", up, down)
+	}
+	return fmt.Sprintf("[%s%s]", up, down)
+}
+
+// selectHelp builds the Select help bar text from the bindings
+// actually in effect.
+func (k KeyMap) selectHelp() string {
+	return fmt.Sprintf("\x1b[38;5;245m%s navigate • [%s] confirm\x1b[0m",
+		k.navLabel(), keyLabel(k.Confirm))
+}
+
+// multiSelectHelp builds the MultiSelect help bar text from the
+// bindings actually in effect.
+func (k KeyMap) multiSelectHelp() string {
+	return fmt.Sprintf("\x1b[38;5;245m%s navigate • [%s] select • [%s] confirm\x1b[0m",
+		k.navLabel(), keyLabel(k.Select), keyLabel(k.Confirm))
+}
+
 // Option represents a selectable option in Select and MultiSelect prompts.
 type Option struct {
 	Text     string
@@ -195,7 +483,11 @@ type Confirm struct {
 	def         bool
 	clearScreen bool
 	theme       *Theme
+	keymap      *KeyMap
 	valuePtr    *bool
+	out         io.Writer
+	in          io.Reader
+	result      bool
 }
 
 // NewConfirm creates a new confirmation prompt.
@@ -225,66 +517,89 @@ func (c Confirm) Value(v *bool) *Confirm {
 	return &c
 }
 
+// KeyMap sets the key bindings for the confirmation prompt.
+func (c Confirm) KeyMap(k *KeyMap) *Confirm {
+	c.keymap = k
+	return &c
+}
+
+// WithOutput sets the writer the prompt renders to. Defaults to os.Stdout.
+func (c Confirm) WithOutput(w io.Writer) *Confirm {
+	c.out = w
+	return &c
+}
+
+// WithInput sets the reader keys are read from, bypassing the controlling
+// terminal. Defaults to reading raw-mode stdin.
+func (c Confirm) WithInput(r io.Reader) *Confirm {
+	c.in = r
+	return &c
+}
+
 // Run executes the confirmation prompt and returns the user's choice.
 // Returns ErrCanceled if the user cancels the operation.
 func (c *Confirm) Run() error {
-	var res bool
+	return runRaw(c.out, c.in, c.runWith)
+}
 
-	err := runRaw(func(t *terminal) error {
-		theme := chooseTheme(c.theme)
-		df := "y/N"
+func (c *Confirm) runWith(t *terminal) error {
+	theme := chooseTheme(c.theme)
+	keymap := chooseKeyMap(c.keymap)
+	df := "y/N"
 
-		if c.def {
-			df = "Y/n"
-		}
+	if c.def {
+		df = "Y/n"
+	}
 
-		t.marginTop(theme.MarginTop)
-		defer t.marginBottom(theme.MarginBottom)
+	t.marginTop(theme.MarginTop)
+	defer t.marginBottom(theme.MarginBottom)
 
-		t.removeCursor()
-		defer t.bringBack()
+	t.removeCursor()
+	defer t.bringBack()
 
-		for {
-			if c.clearScreen {
-				t.clearScreenAndTop()
-			}
+	for {
+		if c.clearScreen {
+			t.clearScreenAndTop()
+		}
 
-			t.clearLine()
-			fmt.Printf("%s [%s]", c.title, df)
+		t.clearLine()
+		t.printf("%s [%s]", c.title, df)
 
-			ev, err := t.kr.ReadEvent()
-			if err != nil {
-				return err
-			}
+		ev, err := t.kr.ReadEvent()
+		if err != nil {
+			return err
+		}
 
-			switch {
-			case ev.IsCtrl('c') || ev.IsCtrl('q'):
-				return errors.New("canceled")
+		switch {
+		case keymap.isCancel(ev):
+			return ErrCanceled
 
-			case ev.Key == key.Enter:
-				res = c.def
-				return nil
+		case keymap.isConfirm(ev):
+			c.result = c.def
+			if c.valuePtr != nil {
+				*c.valuePtr = c.result
+			}
+			return nil
 
-			case ev.Key == key.Rune && (ev.Rune == 'y' || ev.Rune == 'Y'):
-				res = true
-				return nil
+		case ev.Key == key.Rune && (ev.Rune == 'y' || ev.Rune == 'Y'):
+			c.result = true
+			if c.valuePtr != nil {
+				*c.valuePtr = c.result
+			}
+			return nil
 
-			case ev.Key == key.Rune && (ev.Rune == 'n' || ev.Rune == 'N'):
-				res = false
-				return nil
+		case ev.Key == key.Rune && (ev.Rune == 'n' || ev.Rune == 'N'):
+			c.result = false
+			if c.valuePtr != nil {
+				*c.valuePtr = c.result
 			}
+			return nil
 		}
-	})
-
-	if err != nil {
-		return err
-	}
-
-	if c.valuePtr != nil {
-		*c.valuePtr = res
 	}
+}
 
-	return nil
+func (c *Confirm) lastValue() any {
+	return c.result
 }
 
 // InputPrompt provides a text input prompt with optional validation.
@@ -293,7 +608,11 @@ type InputPrompt struct {
 	valuePtr           *string
 	validate           func(string) error
 	theme              *Theme
+	keymap             *KeyMap
 	clearScreen        bool
+	out                io.Writer
+	in                 io.Reader
+	result             string
 }
 
 // NewInput creates a new text input prompt.
@@ -339,109 +658,365 @@ func (ip InputPrompt) Validate(fn func(string) error) *InputPrompt {
 	return &ip
 }
 
+// KeyMap sets the key bindings for the input prompt.
+func (ip InputPrompt) KeyMap(k *KeyMap) *InputPrompt {
+	ip.keymap = k
+	return &ip
+}
+
+// WithOutput sets the writer the prompt renders to. Defaults to os.Stdout.
+func (ip InputPrompt) WithOutput(w io.Writer) *InputPrompt {
+	ip.out = w
+	return &ip
+}
+
+// WithInput sets the reader keys are read from, bypassing the controlling
+// terminal. Defaults to reading raw-mode stdin.
+func (ip InputPrompt) WithInput(r io.Reader) *InputPrompt {
+	ip.in = r
+	return &ip
+}
+
 // Run executes the input prompt and returns the user's input.
 // Returns ErrCanceled if the user cancels the operation.
 // Returns validation error if the input fails validation.
 func (ip *InputPrompt) Run() error {
-	var res string
+	return runRaw(ip.out, ip.in, ip.runWith)
+}
+
+func (ip *InputPrompt) runWith(t *terminal) error {
+	var buf []rune
 
-	err := runRaw(func(t *terminal) error {
-		var buf []rune
+	if ip.valuePtr != nil && *ip.valuePtr != "" {
+		buf = []rune(*ip.valuePtr)
+	}
 
-		if ip.valuePtr != nil && *ip.valuePtr != "" {
-			buf = []rune(*ip.valuePtr)
+	theme := chooseTheme(ip.theme)
+	keymap := chooseKeyMap(ip.keymap)
+	cursor := len(buf)
+
+	t.marginTop(theme.MarginTop)
+	defer t.marginBottom(theme.MarginBottom)
+
+	for {
+		if ip.clearScreen {
+			t.clearScreenAndTop()
 		}
 
-		theme := chooseTheme(ip.theme)
-		cursor := len(buf)
+		t.clearLine()
+
+		prefix := 0
+		if ip.title != "" {
+			t.printf("%s", ip.title)
+			prefix = runewidth.StringWidth(ip.title)
+		}
 
-		t.marginTop(theme.MarginTop)
-		defer t.marginBottom(theme.MarginBottom)
+		if len(buf) == 0 && ip.placeholder != "" {
+			t.printf("\x1b[38;5;241m%s\x1b[0m", ip.placeholder)
+		} else {
+			t.printf("%s", string(buf))
+		}
 
-		for {
-			if ip.clearScreen {
-				t.clearScreenAndTop()
-			}
+		textW := 0
+		if len(buf) > 0 {
+			textW = runewidth.StringWidth(string(buf[:cursor]))
+		}
 
-			t.clearLine()
+		t.moveCursorRight(prefix + textW)
 
-			prefix := 0
-			if ip.title != "" {
-				fmt.Printf("%s", ip.title)
-				prefix = runewidth.StringWidth(ip.title)
-			}
+		ev, err := t.kr.ReadEvent()
+		if err != nil {
+			return err
+		}
 
-			if len(buf) == 0 && ip.placeholder != "" {
-				fmt.Printf("\x1b[38;5;241m%s\x1b[0m", ip.placeholder)
-			} else {
-				fmt.Printf("%s", string(buf))
+		switch {
+		case keymap.isCancel(ev):
+			return ErrCanceled
+		case keymap.isConfirm(ev):
+			ip.result = string(buf)
+
+			if ip.validate != nil {
+				if err := ip.validate(ip.result); err != nil {
+					return err
+				}
+			}
+			if ip.valuePtr != nil {
+				*ip.valuePtr = ip.result
 			}
 
-			textW := 0
-			if len(buf) > 0 {
-				textW = runewidth.StringWidth(string(buf[:cursor]))
+			return nil
+		case ev.Key == key.Backspace:
+			if cursor > 0 {
+				buf = append(buf[:cursor-1], buf[cursor:]...)
+				cursor--
 			}
+		case ev.Key == key.Left:
+			if cursor > 0 {
+				cursor--
+			}
+		case ev.Key == key.Right:
+			if cursor < len(buf) {
+				cursor++
+			}
+		case ev.Key == key.Rune:
+			buf = append(buf[:cursor], append([]rune{ev.Rune}, buf[cursor:]...)...)
+			cursor++
+		case ev.Key == key.Space:
+			buf = append(buf[:cursor], append([]rune{' '}, buf[cursor:]...)...)
+			cursor++
+		}
+	}
+}
 
-			t.moveCursorRight(prefix + textW)
+func (ip *InputPrompt) lastValue() any {
+	return ip.result
+}
 
-			ev, err := t.kr.ReadEvent()
-			if err != nil {
-				return err
-			}
+// Password provides a text input prompt that masks the characters typed,
+// suitable for secrets like passwords or tokens.
+type Password struct {
+	title       string
+	valuePtr    *string
+	validate    func(string) error
+	theme       *Theme
+	keymap      *KeyMap
+	clearScreen bool
+	out         io.Writer
+	in          io.Reader
+	result      string
+}
 
-			switch {
-			case ev.IsCtrl('c'), ev.IsCtrl('q'):
-				return ErrCanceled
-			case ev.Key == key.Enter:
-				res = string(buf)
+// NewPassword creates a new masked input prompt.
+func NewPassword() *Password {
+	return &Password{}
+}
 
-				if ip.validate != nil {
-					if err := ip.validate(res); err != nil {
-						return err
-					}
-				}
-				if ip.valuePtr != nil {
-					*ip.valuePtr = res
-				}
+// Title sets the title text displayed
+func (p Password) Title(s string) *Password {
+	p.title = s
+	return &p
+}
 
-				return nil
-			case ev.Key == key.Backspace:
-				if cursor > 0 {
-					buf = append(buf[:cursor-1], buf[cursor:]...)
-					cursor--
-				}
-			case ev.Key == key.Left:
-				if cursor > 0 {
-					cursor--
-				}
-			case ev.Key == key.Right:
-				if cursor < len(buf) {
-					cursor++
+// Value sets a pointer to a string variable where the input will be stored.
+func (p Password) Value(v *string) *Password {
+	p.valuePtr = v
+	return &p
+}
+
+// Theme sets the theme for the password prompt.
+func (p Password) Theme(t *Theme) *Password {
+	p.theme = t
+	return &p
+}
+
+// KeyMap sets the key bindings for the password prompt.
+func (p Password) KeyMap(k *KeyMap) *Password {
+	p.keymap = k
+	return &p
+}
+
+// ClearScreen sets whether to clear the terminal screen before showing the prompt.
+func (p Password) ClearScreen(on bool) *Password {
+	p.clearScreen = on
+	return &p
+}
+
+// Validate sets a validation function that will be called on form submission.
+// The function should return an error if the input is invalid.
+func (p Password) Validate(fn func(string) error) *Password {
+	p.validate = fn
+	return &p
+}
+
+// WithOutput sets the writer the prompt renders to. Defaults to os.Stdout.
+func (p Password) WithOutput(w io.Writer) *Password {
+	p.out = w
+	return &p
+}
+
+// WithInput sets the reader keys are read from, bypassing the controlling
+// terminal. Defaults to reading raw-mode stdin.
+func (p Password) WithInput(r io.Reader) *Password {
+	p.in = r
+	return &p
+}
+
+// Run executes the password prompt and returns the user's input.
+// Returns ErrCanceled if the user cancels the operation.
+// Returns validation error if the input fails validation.
+//
+// The typed characters are never rendered verbatim; the theme's Mask
+// rune is echoed instead, and the rune buffer is scrubbed before Run
+// returns.
+func (p *Password) Run() error {
+	return runRaw(p.out, p.in, p.runWith)
+}
+
+func (p *Password) runWith(t *terminal) error {
+	var buf []rune
+	cursor := 0
+
+	theme := chooseTheme(p.theme)
+	keymap := chooseKeyMap(p.keymap)
+	mask := theme.Mask
+	if mask == 0 {
+		mask = '*'
+	}
+
+	t.marginTop(theme.MarginTop)
+	defer t.marginBottom(theme.MarginBottom)
+
+	defer func() {
+		for i := range buf {
+			buf[i] = 0
+		}
+	}()
+
+	for {
+		if p.clearScreen {
+			t.clearScreenAndTop()
+		}
+
+		t.clearLine()
+
+		prefix := 0
+		if p.title != "" {
+			t.printf("%s", p.title)
+			prefix = runewidth.StringWidth(p.title)
+		}
+
+		t.print(strings.Repeat(string(mask), len(buf)))
+
+		maskW := runewidth.RuneWidth(mask) * cursor
+		t.moveCursorRight(prefix + maskW)
+
+		ev, err := t.kr.ReadEvent()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case keymap.isCancel(ev):
+			return ErrCanceled
+		case keymap.isConfirm(ev):
+			p.result = string(buf)
+
+			if p.validate != nil {
+				if err := p.validate(p.result); err != nil {
+					return err
 				}
-			case ev.Key == key.Rune:
-				buf = append(buf[:cursor], append([]rune{ev.Rune}, buf[cursor:]...)...)
-				cursor++
-			case ev.Key == key.Space:
-				buf = append(buf[:cursor], append([]rune{' '}, buf[cursor:]...)...)
+			}
+			if p.valuePtr != nil {
+				*p.valuePtr = p.result
+			}
+
+			return nil
+		case ev.Key == key.Backspace:
+			if cursor > 0 {
+				buf = append(buf[:cursor-1], buf[cursor:]...)
+				cursor--
+			}
+		case ev.Key == key.Left:
+			if cursor > 0 {
+				cursor--
+			}
+		case ev.Key == key.Right:
+			if cursor < len(buf) {
 				cursor++
 			}
+		case ev.Key == key.Rune:
+			buf = append(buf[:cursor], append([]rune{ev.Rune}, buf[cursor:]...)...)
+			cursor++
+		case ev.Key == key.Space:
+			buf = append(buf[:cursor], append([]rune{' '}, buf[cursor:]...)...)
+			cursor++
 		}
-	})
+	}
+}
 
-	if err != nil {
-		return err
+func (p *Password) lastValue() any {
+	return p.result
+}
+
+// pageWindow computes the visible [top, end) slice of a paged option list,
+// scrolling top just enough to keep cursor inside the window. page <= 0
+// or page >= total disables paging, returning the whole list.
+func pageWindow(top, cursor, total, page int) (int, int) {
+	if page <= 0 || page >= total {
+		return 0, total
 	}
 
-	return nil
+	if cursor < top {
+		top = cursor
+	} else if cursor >= top+page {
+		top = cursor - page + 1
+	}
+
+	return top, top + page
+}
+
+// FilterFunc reports whether optionText matches the current filter
+// input. idx is the option's index in the original, unfiltered list.
+type FilterFunc func(input, optionText string, idx int) bool
+
+// defaultFilter matches input as a case-insensitive substring of optionText.
+func defaultFilter(input, optionText string, idx int) bool {
+	return strings.Contains(strings.ToLower(optionText), strings.ToLower(input))
+}
+
+// FuzzyFilter matches optionText against input as a case-insensitive
+// subsequence, fzf-style (e.g. "br" matches "bread"). Pass it to Filter
+// to opt into fuzzy matching instead of the default substring match.
+func FuzzyFilter(input, optionText string, idx int) bool {
+	in := []rune(strings.ToLower(input))
+	text := []rune(strings.ToLower(optionText))
+
+	i := 0
+
+	for _, r := range text {
+		if i >= len(in) {
+			break
+		}
+		if r == in[i] {
+			i++
+		}
+	}
+
+	return i == len(in)
+}
+
+// filterOptions narrows options down to those matching input via fn.
+// An empty input matches everything.
+func filterOptions(options []*Option, input string, fn FilterFunc) []*Option {
+	if input == "" {
+		return options
+	}
+
+	out := make([]*Option, 0, len(options))
+
+	for i, opt := range options {
+		if fn(input, opt.Text, i) {
+			out = append(out, opt)
+		}
+	}
+
+	return out
 }
 
 // Select provides a single-selection prompt from a list of options.
 type Select struct {
-	title      string
-	options    []*Option
-	valuePtr   any
-	theme      *Theme
-	clearSreen bool
+	title        string
+	options      []*Option
+	valuePtr     any
+	theme        *Theme
+	keymap       *KeyMap
+	clearSreen   bool
+	pageSize     int
+	filterFn     FilterFunc
+	filterPrompt string
+	out          io.Writer
+	in           io.Reader
+	result       any
 }
 
 // NewSelect creates a new single-selection prompt.
@@ -483,88 +1058,220 @@ func (s Select) ClearScreen(on bool) *Select {
 	return &s
 }
 
+// PageSize limits how many options are rendered at once, scrolling the
+// visible window as the cursor moves instead of dumping the whole list.
+// n <= 0 (the default) disables paging.
+func (s Select) PageSize(n int) *Select {
+	s.pageSize = n
+	return &s
+}
+
+// Filter sets the predicate used for incremental type-to-filter. It
+// defaults to a case-insensitive substring match; pass FuzzyFilter for
+// fzf-style subsequence matching instead.
+func (s Select) Filter(fn FilterFunc) *Select {
+	s.filterFn = fn
+	return &s
+}
+
+// FilterPrompt sets the header text shown above the options while a
+// filter is active.
+func (s Select) FilterPrompt(p string) *Select {
+	s.filterPrompt = p
+	return &s
+}
+
+// KeyMap sets the key bindings for the selection prompt.
+func (s Select) KeyMap(k *KeyMap) *Select {
+	s.keymap = k
+	return &s
+}
+
+// WithOutput sets the writer the prompt renders to. Defaults to os.Stdout.
+func (s Select) WithOutput(w io.Writer) *Select {
+	s.out = w
+	return &s
+}
+
+// WithInput sets the reader keys are read from, bypassing the controlling
+// terminal. Defaults to reading raw-mode stdin.
+func (s Select) WithInput(r io.Reader) *Select {
+	s.in = r
+	return &s
+}
+
 // Run executes the selection prompt and returns the user's choice.
 // Returns ErrCanceled if the user cancels the operation.
 // Returns an error if no options are provided.
 func (s *Select) Run() error {
+	return runRaw(s.out, s.in, s.runWith)
+}
+
+func (s *Select) runWith(t *terminal) error {
 	if len(s.options) == 0 {
 		return errors.New("no options")
 	}
 
-	err := runRaw(func(t *terminal) error {
-		cursor := 0
-		theme := chooseTheme(s.theme)
+	filterFn := s.filterFn
+	if filterFn == nil {
+		filterFn = defaultFilter
+	}
+
+	cursor := 0
+	top := 0
+	var filter []rune
+	theme := chooseTheme(s.theme)
+	keymap := chooseKeyMap(s.keymap)
+
+	t.marginTop(theme.MarginTop)
+	defer t.marginBottom(theme.MarginBottom - 1)
+	t.removeCursor()
+	defer t.bringBack()
+
+	if s.title != "" {
+		t.println(s.title)
+	}
+
+	fr := newFrame(t)
+	defer fr.stop()
+
+	for {
+		fr.checkResize()
 
-		t.marginTop(theme.MarginTop)
-		defer t.marginBottom(theme.MarginBottom - 1)
-		t.removeCursor()
-		defer t.bringBack()
+		if s.clearSreen {
+			t.clearScreenAndTop()
+			fr.prev = nil
+		}
 
-		if s.title != "" {
-			fmt.Println(s.title)
+		visible := filterOptions(s.options, string(filter), filterFn)
+		if cursor >= len(visible) {
+			cursor = len(visible) - 1
+		}
+		if cursor < 0 {
+			cursor = 0
 		}
 
-		for {
-			if s.clearSreen {
-				t.clearScreenAndTop()
+		paging := s.pageSize > 0 && s.pageSize < len(visible)
+
+		if len(filter) > 0 || s.filterPrompt != "" {
+			fp := s.filterPrompt
+			if fp == "" {
+				fp = "Filter: "
+			}
+			fr.line("%s%s", fp, string(filter))
+		}
+
+		var end int
+		top, end = pageWindow(top, cursor, len(visible), s.pageSize)
+
+		if paging {
+			if top > 0 {
+				fr.line("↑ more")
 			} else {
-				t.clearLine()
+				fr.line("")
 			}
+		}
 
-			for i, opt := range s.options {
-				prefix := "  "
+		for i := top; i < end; i++ {
+			opt := visible[i]
+			prefix := "  "
 
-				if i == cursor {
-					prefix = theme.Prompt
-				}
+			if i == cursor {
+				prefix = theme.Pointer
+			}
+
+			fr.line("%s%s", prefix, opt.Text)
+		}
+
+		if len(visible) == 0 {
+			fr.line("no matches")
+		}
 
-				fmt.Printf("\r%s%s\n", prefix, opt.Text)
+		if paging {
+			if end < len(visible) {
+				fr.line("↓ more")
+			} else {
+				fr.line("")
 			}
+		}
 
-			t.helpBar(theme.SelectHelp)
+		fr.flush()
+		help := theme.SelectHelp
+		if help == "" {
+			help = keymap.selectHelp()
+		}
+		t.helpBar(help)
 
-			ev, err := t.kr.ReadEvent()
-			if err != nil {
-				return err
+		ev, err := t.kr.ReadEvent()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case keymap.isCancel(ev):
+			return ErrCanceled
+		case ev.Key == key.Rune && len(filter) > 0:
+			// Vim's bare j/k nav would otherwise swallow these runes
+			// before they ever reach the filter-append case below.
+			filter = append(filter, ev.Rune)
+			cursor, top = 0, 0
+		case keymap.isUp(ev):
+			if cursor > 0 {
+				cursor--
+			}
+		case keymap.isDown(ev):
+			if cursor < len(visible)-1 {
+				cursor++
+			}
+		case keymap.isConfirm(ev):
+			if len(visible) == 0 {
+				continue
 			}
 
-			switch {
-			case ev.IsCtrl('c'), ev.IsCtrl('q'):
-				return ErrCanceled
-			case ev.Key == key.Up:
-				if cursor > 0 {
-					cursor--
-				}
-			case ev.Key == key.Down:
-				if cursor < len(s.options)-1 {
-					cursor++
-				}
-			case ev.Key == key.Enter:
-				if s.valuePtr != nil {
-					selectedValue := s.options[cursor].Value
-					ptrValue := reflect.ValueOf(s.valuePtr)
-					if ptrValue.Kind() != reflect.Ptr {
-						return errors.New("value must be a pointer")
-					}
-					ptrValue.Elem().Set(reflect.ValueOf(selectedValue))
+			s.result = visible[cursor].Value
+
+			if s.valuePtr != nil {
+				ptrValue := reflect.ValueOf(s.valuePtr)
+				if ptrValue.Kind() != reflect.Ptr {
+					return errors.New("value must be a pointer")
 				}
-				return nil
+				ptrValue.Elem().Set(reflect.ValueOf(s.result))
 			}
 
-			t.moveCursorUp(len(s.options))
+			return nil
+		case ev.Key == key.Backspace:
+			if len(filter) > 0 {
+				filter = filter[:len(filter)-1]
+				cursor, top = 0, 0
+			}
+		case ev.Key == key.Space:
+			filter = append(filter, ' ')
+			cursor, top = 0, 0
+		case ev.Key == key.Rune:
+			filter = append(filter, ev.Rune)
+			cursor, top = 0, 0
 		}
-	})
+	}
+}
 
-	return err
+func (s *Select) lastValue() any {
+	return s.result
 }
 
 // MultiSelect provides a multiple-selection prompt from a list of options.
 type MultiSelect struct {
-	title       string
-	options     []*Option
-	valuePtr    any
-	theme       *Theme
-	clearScreen bool
+	title        string
+	options      []*Option
+	valuePtr     any
+	theme        *Theme
+	keymap       *KeyMap
+	clearScreen  bool
+	pageSize     int
+	filterFn     FilterFunc
+	filterPrompt string
+	out          io.Writer
+	in           io.Reader
+	result       []any
 }
 
 // NewMultiSelect creates a new multiple-selection prompt.
@@ -603,115 +1310,359 @@ func (m MultiSelect) ClearScreen(on bool) *MultiSelect {
 	return &m
 }
 
+// PageSize limits how many options are rendered at once, scrolling the
+// visible window as the cursor moves instead of dumping the whole list.
+// n <= 0 (the default) disables paging.
+func (m MultiSelect) PageSize(n int) *MultiSelect {
+	m.pageSize = n
+	return &m
+}
+
+// Filter sets the predicate used for incremental type-to-filter. It
+// defaults to a case-insensitive substring match; pass FuzzyFilter for
+// fzf-style subsequence matching instead.
+func (m MultiSelect) Filter(fn FilterFunc) *MultiSelect {
+	m.filterFn = fn
+	return &m
+}
+
+// FilterPrompt sets the header text shown above the options while a
+// filter is active.
+func (m MultiSelect) FilterPrompt(p string) *MultiSelect {
+	m.filterPrompt = p
+	return &m
+}
+
+// KeyMap sets the key bindings for the multi-selection prompt.
+func (m MultiSelect) KeyMap(k *KeyMap) *MultiSelect {
+	m.keymap = k
+	return &m
+}
+
+// WithOutput sets the writer the prompt renders to. Defaults to os.Stdout.
+func (m MultiSelect) WithOutput(w io.Writer) *MultiSelect {
+	m.out = w
+	return &m
+}
+
+// WithInput sets the reader keys are read from, bypassing the controlling
+// terminal. Defaults to reading raw-mode stdin.
+func (m MultiSelect) WithInput(r io.Reader) *MultiSelect {
+	m.in = r
+	return &m
+}
+
 // Run executes the multi-selection prompt and returns the user's choices.
 // Returns ErrCanceled if the user cancels the operation.
 // Returns an error if no options are provided.
 func (m *MultiSelect) Run() error {
+	return runRaw(m.out, m.in, m.runWith)
+}
+
+func (m *MultiSelect) runWith(t *terminal) error {
 	if len(m.options) == 0 {
 		return errors.New("no options")
 	}
 
-	err := runRaw(func(t *terminal) error {
-		cursor := 0
-		theme := chooseTheme(m.theme)
+	filterFn := m.filterFn
+	if filterFn == nil {
+		filterFn = defaultFilter
+	}
+
+	cursor := 0
+	top := 0
+	var filter []rune
+	theme := chooseTheme(m.theme)
+	keymap := chooseKeyMap(m.keymap)
+
+	t.marginTop(theme.MarginTop)
+	defer t.marginBottom(theme.MarginBottom - 1)
+
+	t.removeCursor()
+	defer t.bringBack()
+
+	if m.title != "" {
+		t.println(m.title)
+	}
 
-		t.marginTop(theme.MarginTop)
-		defer t.marginBottom(theme.MarginBottom - 1)
+	fr := newFrame(t)
+	defer fr.stop()
 
-		t.removeCursor()
-		defer t.bringBack()
+	for {
+		fr.checkResize()
 
-		if m.title != "" {
-			fmt.Println(m.title)
+		if m.clearScreen {
+			t.clearScreenAndTop()
+			fr.prev = nil
 		}
 
-		for {
-			if m.clearScreen {
-				t.clearScreenAndTop()
-			} else {
-				t.clearLine()
+		visible := filterOptions(m.options, string(filter), filterFn)
+		if cursor >= len(visible) {
+			cursor = len(visible) - 1
+		}
+		if cursor < 0 {
+			cursor = 0
+		}
+
+		paging := m.pageSize > 0 && m.pageSize < len(visible)
+
+		if len(filter) > 0 || m.filterPrompt != "" {
+			fp := m.filterPrompt
+			if fp == "" {
+				fp = "Filter: "
 			}
+			fr.line("%s%s", fp, string(filter))
+		}
 
-			for i, opt := range m.options {
-				fmt.Printf("\r")
-				mark := theme.Unselected
+		var end int
+		top, end = pageWindow(top, cursor, len(visible), m.pageSize)
 
-				if opt.selected {
-					mark = theme.Selected
-				}
+		if paging {
+			if top > 0 {
+				fr.line("↑ more")
+			} else {
+				fr.line("")
+			}
+		}
 
-				prefix := "  "
+		for i := top; i < end; i++ {
+			opt := visible[i]
+			mark := theme.Unmarker
 
-				if i == cursor {
-					prefix = theme.Prompt
-				}
+			if opt.selected {
+				mark = theme.Marker
+			}
 
-				fmt.Printf("\r%s%s %s\n", prefix, mark, opt.Text)
+			prefix := "  "
+
+			if i == cursor {
+				prefix = theme.Pointer
 			}
 
-			t.helpBar(theme.MultiSelectHelp)
+			fr.line("%s%s %s", prefix, mark, opt.Text)
+		}
 
-			ev, err := t.kr.ReadEvent()
-			if err != nil {
-				return err
+		if len(visible) == 0 {
+			fr.line("no matches")
+		}
+
+		if paging {
+			if end < len(visible) {
+				fr.line("↓ more")
+			} else {
+				fr.line("")
 			}
+		}
 
-			switch {
-			case ev.IsCtrl('c'), ev.IsCtrl('q'):
-				return ErrCanceled
-			case ev.Key == key.Up:
-				if cursor > 0 {
-					cursor--
-				}
-			case ev.Key == key.Down:
-				if cursor < len(m.options)-1 {
-					cursor++
+		fr.flush()
+		help := theme.MultiSelectHelp
+		if help == "" {
+			help = keymap.multiSelectHelp()
+		}
+		t.helpBar(help)
+
+		ev, err := t.kr.ReadEvent()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case keymap.isCancel(ev):
+			return ErrCanceled
+		case keymap.isConfirm(ev):
+			m.result = make([]any, 0)
+
+			for _, opt := range m.options {
+				if opt.selected {
+					m.result = append(m.result, opt.Value)
 				}
-			case ev.Key == key.Space:
-				m.options[cursor].selected = !m.options[cursor].selected
-			case ev.Key == key.Enter:
-				if m.valuePtr != nil {
-					ptrValue := reflect.ValueOf(m.valuePtr)
-					if ptrValue.Kind() != reflect.Ptr {
-						return errors.New("value must be a pointer")
-					}
+			}
 
-					elem := ptrValue.Elem()
-					switch elem.Kind() {
-					case reflect.Slice:
-						if elem.Type().Elem().Kind() == reflect.String {
-							// For []string
-							selectedStrings := make([]string, 0)
-
-							for _, opt := range m.options {
-								if opt.selected {
-									if str, ok := opt.Value.(string); ok {
-										selectedStrings = append(selectedStrings, str)
-									}
-								}
-							}
+			if m.valuePtr != nil {
+				ptrValue := reflect.ValueOf(m.valuePtr)
+				if ptrValue.Kind() != reflect.Ptr {
+					return errors.New("value must be a pointer")
+				}
 
-							elem.Set(reflect.ValueOf(selectedStrings))
-						} else {
-							// For other slice types
-							selectedValues := make([]any, 0)
+				elem := ptrValue.Elem()
+				switch elem.Kind() {
+				case reflect.Slice:
+					if elem.Type().Elem().Kind() == reflect.String {
+						selectedStrings := make([]string, 0, len(m.result))
 
-							for _, opt := range m.options {
-								if opt.selected {
-									selectedValues = append(selectedValues, opt.Value)
-								}
+						for _, v := range m.result {
+							if str, ok := v.(string); ok {
+								selectedStrings = append(selectedStrings, str)
 							}
-
-							elem.Set(reflect.ValueOf(selectedValues))
 						}
+
+						elem.Set(reflect.ValueOf(selectedStrings))
+					} else {
+						elem.Set(reflect.ValueOf(m.result))
 					}
 				}
-				return nil
 			}
 
-			t.moveCursorUp(len(m.options))
+			return nil
+		case ev.Key == key.Rune && len(filter) > 0:
+			// Vim's bare j/k nav would otherwise swallow these runes
+			// before they ever reach the filter-append case below.
+			filter = append(filter, ev.Rune)
+			cursor, top = 0, 0
+		case keymap.isUp(ev):
+			if cursor > 0 {
+				cursor--
+			}
+		case keymap.isDown(ev):
+			if cursor < len(visible)-1 {
+				cursor++
+			}
+		case ev.Key == key.Space && len(filter) > 0:
+			filter = append(filter, ' ')
+			cursor, top = 0, 0
+		case keymap.isSelect(ev):
+			if len(visible) > 0 {
+				visible[cursor].selected = !visible[cursor].selected
+			}
+		case keymap.isSelectAll(ev):
+			all := true
+			for _, opt := range visible {
+				if !opt.selected {
+					all = false
+					break
+				}
+			}
+			for _, opt := range visible {
+				opt.selected = !all
+			}
+		case keymap.isInvertSelection(ev):
+			for _, opt := range visible {
+				opt.selected = !opt.selected
+			}
+		case ev.Key == key.Backspace:
+			if len(filter) > 0 {
+				filter = filter[:len(filter)-1]
+				cursor, top = 0, 0
+			}
+		case ev.Key == key.Rune:
+			filter = append(filter, ev.Rune)
+			cursor, top = 0, 0
+		}
+	}
+}
+
+func (m *MultiSelect) lastValue() any {
+	return m.result
+}
+
+// Prompt is implemented by every prompt type in this package. It is
+// the unit of composition accepted by Form.
+type Prompt interface {
+	runWith(t *terminal) error
+	lastValue() any
+}
+
+// Answers stores the results of a Form's prompts, keyed by the name
+// passed to Add or AddIf.
+type Answers map[string]any
+
+// Bool returns the named answer as a bool, or false if absent or of a
+// different type.
+func (a Answers) Bool(key string) bool {
+	v, _ := a[key].(bool)
+	return v
+}
+
+// String returns the named answer as a string, or "" if absent or of
+// a different type.
+func (a Answers) String(key string) string {
+	v, _ := a[key].(string)
+	return v
+}
+
+// Any returns the named answer as-is, or nil if it was never set.
+func (a Answers) Any(key string) any {
+	return a[key]
+}
+
+type formStep struct {
+	key    string
+	prompt Prompt
+	when   func(Answers) bool
+}
+
+// Form runs a sequence of prompts as a single flow: one raw-mode
+// terminal session for the whole run instead of one per prompt, and a
+// shared Answers map later steps can read through AddIf. Use NewForm()
+// to create one.
+type Form struct {
+	steps []formStep
+	out   io.Writer
+	in    io.Reader
+}
+
+// NewForm creates a new, empty form.
+func NewForm() *Form {
+	return &Form{}
+}
+
+// WithOutput sets the writer every step in the form renders to. Defaults
+// to os.Stdout.
+func (f *Form) WithOutput(w io.Writer) *Form {
+	f.out = w
+	return f
+}
+
+// WithInput sets the reader every step in the form reads keys from,
+// bypassing the controlling terminal. Defaults to reading raw-mode stdin.
+func (f *Form) WithInput(r io.Reader) *Form {
+	f.in = r
+	return f
+}
+
+// Add appends an unconditional prompt to the form. Its result is
+// stored in the final Answers under key.
+func (f *Form) Add(key string, p Prompt) *Form {
+	f.steps = append(f.steps, formStep{key: key, prompt: p})
+	return f
+}
+
+// AddIf appends a prompt that only runs when cond returns true for the
+// answers collected from every step before it. Skipped steps leave no
+// entry in the final Answers.
+func (f *Form) AddIf(cond func(Answers) bool, key string, p Prompt) *Form {
+	f.steps = append(f.steps, formStep{key: key, prompt: p, when: cond})
+	return f
+}
+
+// Run executes every step in order inside a single raw-mode session
+// and, if out is non-nil, stores the collected answers into it.
+// Returns ErrCanceled if the user cancels any step.
+func (f *Form) Run(out *Answers) error {
+	answers := Answers{}
+
+	err := runRaw(f.out, f.in, func(t *terminal) error {
+		for _, step := range f.steps {
+			if step.when != nil && !step.when(answers) {
+				continue
+			}
+
+			if err := step.prompt.runWith(t); err != nil {
+				return err
+			}
+
+			answers[step.key] = step.prompt.lastValue()
 		}
+
+		return nil
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	if out != nil {
+		*out = answers
+	}
+
+	return nil
 }
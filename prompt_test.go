@@ -0,0 +1,149 @@
+package prompt
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestInputPromptWithInputOutput drives InputPrompt entirely through
+// WithInput/WithOutput (no controlling terminal involved), the
+// testability this package's renderer split was meant to unlock.
+func TestInputPromptWithInputOutput(t *testing.T) {
+	var out bytes.Buffer
+	var got string
+
+	err := NewInput().
+		Title("Name: ").
+		Value(&got).
+		WithOutput(&out).
+		WithInput(strings.NewReader("Ada\r")).
+		Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if got != "Ada" {
+		t.Errorf("got %q, want %q", got, "Ada")
+	}
+
+	if out.Len() == 0 {
+		t.Error("expected the prompt to render into the provided output buffer")
+	}
+}
+
+// TestSelectFilter types a query that narrows the options down to a
+// single match before confirming, exercising the type-to-filter path.
+func TestSelectFilter(t *testing.T) {
+	var out bytes.Buffer
+	var got string
+
+	opts := []*Option{
+		NewOption("Apple", "apple"),
+		NewOption("Banana", "banana"),
+		NewOption("Cherry", "cherry"),
+	}
+
+	err := NewSelect().
+		Title("Fruit:").
+		Options(opts).
+		Value(&got).
+		WithOutput(&out).
+		WithInput(strings.NewReader("ban\r")).
+		Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if got != "banana" {
+		t.Errorf("got %q, want %q", got, "banana")
+	}
+}
+
+// TestMultiSelectFilterAndSelectAll narrows the options with a filter,
+// then Ctrl+A's the filtered view, checking SelectAll operates on the
+// visible (filtered) options rather than the full list.
+func TestMultiSelectFilterAndSelectAll(t *testing.T) {
+	var out bytes.Buffer
+	var got []string
+
+	opts := []*Option{
+		NewOption("Apple", "apple"),
+		NewOption("Banana", "banana"),
+		NewOption("Orange", "orange"),
+	}
+
+	err := NewMultiSelect().
+		Title("Fruits:").
+		Options(opts).
+		Value(&got).
+		WithOutput(&out).
+		WithInput(strings.NewReader("an\x01\r")).
+		Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := []string{"banana", "orange"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// TestFormAddAddIf drives a multi-step Form through a single
+// WithInput/WithOutput session, checking that a later AddIf step sees
+// the earlier step's answer and that both valuePtr and Answers agree.
+func TestFormAddAddIf(t *testing.T) {
+	var out bytes.Buffer
+	var answers Answers
+	var subscribe bool
+	var name string
+
+	form := NewForm().
+		WithOutput(&out).
+		WithInput(strings.NewReader("yAda\r")).
+		Add("subscribe", NewConfirm().Title("Subscribe?").Value(&subscribe)).
+		AddIf(func(a Answers) bool { return a.Bool("subscribe") },
+			"name", NewInput().Title("Name:").Value(&name))
+
+	if err := form.Run(&answers); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if !subscribe {
+		t.Error("expected subscribe valuePtr to be updated")
+	}
+	if !answers.Bool("subscribe") {
+		t.Error("expected subscribe answer to be true")
+	}
+	if name != "Ada" {
+		t.Errorf("name = %q, want %q", name, "Ada")
+	}
+	if answers.String("name") != "Ada" {
+		t.Errorf("answers[name] = %q, want %q", answers.String("name"), "Ada")
+	}
+}
+
+// TestFormAddIfSkipsStep checks that a false AddIf condition skips its
+// step entirely, leaving no entry in the final Answers.
+func TestFormAddIfSkipsStep(t *testing.T) {
+	var out bytes.Buffer
+	var answers Answers
+	var subscribe bool
+
+	form := NewForm().
+		WithOutput(&out).
+		WithInput(strings.NewReader("n")).
+		Add("subscribe", NewConfirm().Title("Subscribe?").Value(&subscribe)).
+		AddIf(func(a Answers) bool { return a.Bool("subscribe") },
+			"name", NewInput().Title("Name:"))
+
+	if err := form.Run(&answers); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if _, ok := answers["name"]; ok {
+		t.Error("expected the skipped step to leave no entry in Answers")
+	}
+}